@@ -0,0 +1,58 @@
+package sqrl
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+// bindArgs interpolates args into a `?`-placeholder SQL string, rendering
+// each value as an inline literal per dialect d. It is the implementation
+// behind DeleteBuilder.ToBoundSql, the only builder with a ToBoundSql method.
+func bindArgs(d Dialect, sql string, args []interface{}) (string, error) {
+	i := 0
+	return replacePlaceholders(sql, func(buf *bytes.Buffer, _ int) error {
+		if i >= len(args) {
+			return fmt.Errorf("sqrl: not enough arguments for placeholders in query")
+		}
+		literal, err := boundSqlLiteral(d, args[i])
+		if err != nil {
+			return err
+		}
+		i++
+		buf.WriteString(literal)
+		return nil
+	})
+}
+
+// boundSqlLiteral renders a single bound value as an inline SQL literal,
+// quoted/escaped per dialect d.
+func boundSqlLiteral(d Dialect, value interface{}) (string, error) {
+	if valuer, ok := value.(driver.Valuer); ok {
+		v, err := valuer.Value()
+		if err != nil {
+			return "", err
+		}
+		value = v
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return "NULL", nil
+	case bool:
+		return d.BoolLiteral(v), nil
+	case []byte:
+		return d.BytesLiteral(v), nil
+	case time.Time:
+		return d.TimeLiteral(v), nil
+	case string:
+		return d.StringLiteral(v), nil
+	case int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64,
+		float32, float64:
+		return fmt.Sprintf("%v", v), nil
+	default:
+		return d.StringLiteral(fmt.Sprintf("%v", v)), nil
+	}
+}
@@ -0,0 +1,89 @@
+package sqrl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteBuilderToBoundSql(t *testing.T) {
+	b := Delete("a").Where("id = ? AND name = ? AND deleted = ?", 42, "bob", true)
+
+	sql, err := b.ToBoundSql()
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETE FROM a WHERE id = 42 AND name = 'bob' AND deleted = TRUE", sql)
+}
+
+func TestDeleteBuilderToBoundSqlNull(t *testing.T) {
+	b := Delete("a").Where("deleted_at = ?", nil)
+
+	sql, err := b.ToBoundSql()
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETE FROM a WHERE deleted_at = NULL", sql)
+}
+
+func TestDeleteBuilderToBoundSqlTime(t *testing.T) {
+	at := time.Date(2026, 7, 29, 12, 30, 0, 0, time.UTC)
+	b := Delete("a").Where("created_at < ?", at)
+
+	sql, err := b.ToBoundSql()
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETE FROM a WHERE created_at < '2026-07-29 12:30:00'", sql)
+}
+
+func TestDeleteBuilderToBoundSqlBytes(t *testing.T) {
+	cases := []struct {
+		dialect  Dialect
+		expected string
+	}{
+		{MySQL, "DELETE FROM a WHERE data = X'cafe'"},
+		{SQLite, "DELETE FROM a WHERE data = X'cafe'"},
+		{Postgres, `DELETE FROM a WHERE data = '\xcafe'`},
+	}
+
+	for _, c := range cases {
+		sql, err := StatementBuilder.Dialect(c.dialect).Delete("a").
+			Where("data = ?", []byte{0xca, 0xfe}).
+			ToBoundSql()
+		assert.NoError(t, err)
+		assert.Equal(t, c.expected, sql)
+	}
+}
+
+func TestDeleteBuilderToBoundSqlDialects(t *testing.T) {
+	cases := []struct {
+		dialect  Dialect
+		expected string
+	}{
+		{MySQL, "DELETE FROM a WHERE name = 'bob' AND deleted = 1"},
+		{Postgres, "DELETE FROM a WHERE name = 'bob' AND deleted = TRUE"},
+		{SQLite, "DELETE FROM a WHERE name = 'bob' AND deleted = 1"},
+	}
+
+	for _, c := range cases {
+		b := StatementBuilder.Dialect(c.dialect).Delete("a").
+			Where("name = ? AND deleted = ?", "bob", true)
+
+		sql, err := b.ToBoundSql()
+		assert.NoError(t, err)
+		assert.Equal(t, c.expected, sql)
+	}
+}
+
+func TestDeleteBuilderToBoundSqlQuoteEscaping(t *testing.T) {
+	input := `a\'b`
+
+	sql, err := Delete("a").Where("name = ?", input).ToBoundSql()
+	assert.NoError(t, err)
+	assert.Equal(t, `DELETE FROM a WHERE name = 'a\''b'`, sql)
+
+	sql, err = StatementBuilder.Dialect(MySQL).Delete("a").Where("name = ?", input).ToBoundSql()
+	assert.NoError(t, err)
+	assert.Equal(t, `DELETE FROM a WHERE name = 'a\\''b'`, sql)
+}
+
+func TestDeleteBuilderToBoundSqlErr(t *testing.T) {
+	_, err := Delete("").ToBoundSql()
+	assert.Error(t, err)
+}
@@ -0,0 +1,100 @@
+package sqrl
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// defaultBatchChunkSize is used by BatchExec when neither an explicit
+// chunkSize nor a bound dialect's MaxPlaceholders constrain it.
+const defaultBatchChunkSize = 1000
+
+// WhereIn adds a "col IN (values...)" WHERE expression, same as
+// Where(Eq{col: values}), and additionally remembers col/values so that
+// BatchExec can later replace the single IN(...) clause with one chunk per
+// call instead of sending every value at once. values may be any slice or
+// array type, e.g. []int64 or []string; anything else is recorded as an
+// error that ToSql/Exec/BatchExec return instead of panicking.
+func (b *DeleteBuilder) WhereIn(col string, values interface{}) *DeleteBuilder {
+	if !isListType(values) {
+		b.batchErr = fmt.Errorf("sqrl: WhereIn requires a slice or array, got %T", values)
+		return b
+	}
+
+	valVal := reflect.ValueOf(values)
+	items := make([]interface{}, valVal.Len())
+	for i := range items {
+		items[i] = valVal.Index(i).Interface()
+	}
+
+	b.batchCol = col
+	b.batchValues = items
+	b.batchWherePartIdx = len(b.whereParts)
+	b.whereParts = append(b.whereParts, newWherePart(Eq{col: items}))
+	return b
+}
+
+// BatchExec executes the DELETE once per chunk of the WhereIn values,
+// within the current transaction if RunWith was given one, and returns the
+// sum of RowsAffected across all chunks.
+//
+// chunkSize caps how many values are placed in a single IN(...) clause. If
+// chunkSize is 0, or larger than the bound dialect's MaxPlaceholders (e.g.
+// 65535 for Postgres, 999 for SQLite, ~2100 for SQL Server) less the
+// statement's other placeholders, that remaining room is used instead, so
+// chunks never exceed what the driver accepts.
+func (b *DeleteBuilder) BatchExec(ctx context.Context, chunkSize int) (int64, error) {
+	if b.runWith == nil {
+		return 0, ErrRunnerNotSet
+	}
+	if b.batchErr != nil {
+		return 0, b.batchErr
+	}
+	if b.batchCol == "" {
+		return 0, fmt.Errorf("sqrl: BatchExec requires WhereIn to be set")
+	}
+
+	size := chunkSize
+	if b.dialect != nil {
+		if max := b.dialect.MaxPlaceholders(); max > 0 {
+			// Leave room for the statement's other placeholders (e.g. other
+			// Where args) so that a full chunk stays within max.
+			_, args, err := b.toSqlRaw()
+			if err != nil {
+				return 0, err
+			}
+			if avail := max - (len(args) - len(b.batchValues)); avail > 0 && (size <= 0 || size > avail) {
+				size = avail
+			}
+		}
+	}
+	if size <= 0 {
+		size = defaultBatchChunkSize
+	}
+
+	var total int64
+	for start := 0; start < len(b.batchValues); start += size {
+		end := start + size
+		if end > len(b.batchValues) {
+			end = len(b.batchValues)
+		}
+
+		chunk := *b
+		chunk.whereParts = append([]Sqlizer{}, b.whereParts...)
+		chunk.whereParts[b.batchWherePartIdx] = newWherePart(Eq{b.batchCol: b.batchValues[start:end]})
+
+		res, err := chunk.ExecContext(ctx)
+		if err != nil {
+			return total, err
+		}
+
+		n, err := res.RowsAffected()
+		if err != nil {
+			return total, err
+		}
+		total += n
+	}
+
+	return total, nil
+}
@@ -0,0 +1,110 @@
+package sqrl
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// batchRecordingStub implements BaseRunner, recording every Exec call
+// instead of just the last one like DBStub, so chunked BatchExec calls can
+// be asserted individually.
+type batchRecordingStub struct {
+	res sql.Result
+
+	execSqls []string
+	execArgs [][]interface{}
+}
+
+func (s *batchRecordingStub) Exec(query string, args ...interface{}) (sql.Result, error) {
+	s.execSqls = append(s.execSqls, query)
+	s.execArgs = append(s.execArgs, args)
+	return s.res, nil
+}
+
+func (s *batchRecordingStub) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return s.Exec(query, args...)
+}
+
+func (s *batchRecordingStub) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return nil, nil
+}
+
+func (s *batchRecordingStub) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return nil, nil
+}
+
+func TestDeleteBatchExecNoRunner(t *testing.T) {
+	b := Delete("a").WhereIn("id", []int64{1, 2, 3})
+
+	_, err := b.BatchExec(context.Background(), 2)
+	assert.Equal(t, ErrRunnerNotSet, err)
+}
+
+func TestDeleteBatchExecWithoutWhereIn(t *testing.T) {
+	db := &DBStub{res: &resultStub{rowsAffected: 1}}
+	b := Delete("a").RunWith(db)
+
+	_, err := b.BatchExec(context.Background(), 2)
+	assert.Error(t, err)
+}
+
+func TestDeleteBatchExecChunks(t *testing.T) {
+	db := &batchRecordingStub{res: &resultStub{rowsAffected: 2}}
+	b := Delete("a").
+		Where("deleted = ?", false).
+		WhereIn("id", []int64{1, 2, 3, 4, 5}).
+		RunWith(db)
+
+	total, err := b.BatchExec(context.Background(), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), total)
+
+	assert.Equal(t, []string{
+		"DELETE FROM a WHERE deleted = ? AND id IN (?,?)",
+		"DELETE FROM a WHERE deleted = ? AND id IN (?,?)",
+		"DELETE FROM a WHERE deleted = ? AND id IN (?)",
+	}, db.execSqls)
+
+	assert.Equal(t, [][]interface{}{
+		{false, int64(1), int64(2)},
+		{false, int64(3), int64(4)},
+		{false, int64(5)},
+	}, db.execArgs)
+}
+
+func TestDeleteWhereInToSql(t *testing.T) {
+	sql, args, err := Delete("a").
+		Where("deleted = ?", false).
+		WhereIn("id", []int64{1, 2, 3}).
+		ToSql()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETE FROM a WHERE deleted = ? AND id IN (?,?,?)", sql)
+	assert.Equal(t, []interface{}{false, int64(1), int64(2), int64(3)}, args)
+}
+
+func TestDeleteWhereInNotSlice(t *testing.T) {
+	_, _, err := Delete("a").WhereIn("id", 42).ToSql()
+	assert.Error(t, err)
+
+	db := &batchRecordingStub{res: &resultStub{rowsAffected: 1}}
+	_, err = Delete("a").WhereIn("id", 42).RunWith(db).BatchExec(context.Background(), 2)
+	assert.Error(t, err)
+}
+
+func TestDeleteBatchExecDialectChunkSize(t *testing.T) {
+	db := &batchRecordingStub{res: &resultStub{rowsAffected: 1}}
+	b := StatementBuilder.Dialect(SQLite).Delete("a").
+		WhereIn("id", []int64{1, 2, 3}).
+		RunWith(db)
+
+	// chunkSize 0 should fall back to the dialect's MaxPlaceholders (999),
+	// fitting all 3 values into a single chunk.
+	total, err := b.BatchExec(context.Background(), 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Len(t, db.execSqls, 1)
+}
@@ -134,6 +134,39 @@ func TestDeleteBuilderReturning(t *testing.T) {
 	assert.Equal(t, []interface{}{42}, args)
 }
 
+func TestDeleteBuilderReturningAll(t *testing.T) {
+	b := Delete("a").
+		Where("id = ?", 42).
+		ReturningAll()
+
+	sql, args, err := b.ToSql()
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETE FROM a WHERE id = ? RETURNING *", sql)
+	assert.Equal(t, []interface{}{42}, args)
+}
+
+func TestDeleteUsingReturning(t *testing.T) {
+	b := Delete("a1").
+		Using("a2").
+		Where("id = a2.ref_id AND a2.num = ?", 42).
+		ReturningAll()
+
+	sql, args, err := b.ToSql()
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETE FROM a1 USING a2 WHERE id = a2.ref_id AND a2.num = ? RETURNING *", sql)
+	assert.Equal(t, []interface{}{42}, args)
+}
+
+func TestDeleteUsingWithMultiTableErr(t *testing.T) {
+	b := Delete("a1", "a2").
+		From("z1 AS a1").
+		Using("a2").
+		Where("b = ?", 1)
+
+	_, _, err := b.ToSql()
+	assert.Error(t, err)
+}
+
 func TestDeleteBuilderZeroOffsetLimit(t *testing.T) {
 	qb := Delete("").
 		From("b").
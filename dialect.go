@@ -0,0 +1,286 @@
+package sqrl
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Dialect describes the database-specific rendering rules a StatementBuilder
+// can be bound to once via StatementBuilderType.Dialect, instead of callers
+// juggling PlaceholderFormat and per-engine clause syntax by hand. Binding a
+// dialect sets the PlaceholderFormat of every child builder. DeleteBuilder
+// is the only builder that consults the dialect beyond that, for
+// RenderLimitOffset, ReturningKeyword, SupportsDeleteLimitOffset and the
+// literals of ToBoundSql; SelectBuilder, InsertBuilder and UpdateBuilder
+// render LIMIT/OFFSET/RETURNING with their own hardcoded defaults.
+type Dialect interface {
+	// PlaceholderFormat returns the PlaceholderFormat used to render `?`
+	// placeholders for this dialect.
+	PlaceholderFormat() PlaceholderFormat
+
+	// QuoteIdentifier quotes a single SQL identifier (table or column name)
+	// using the dialect's quoting rules. It is exposed for callers that
+	// want to quote an identifier of their own before passing it to e.g.
+	// From/Table/Columns; no builder applies it automatically, since those
+	// methods accept raw SQL fragments (joins, aliases) that can't be
+	// quoted as a single identifier.
+	QuoteIdentifier(name string) string
+
+	// BoolLiteral renders a boolean literal the way the dialect expects it
+	// inlined into SQL text (used by ToBoundSql-style debugging output).
+	BoolLiteral(value bool) string
+
+	// RenderLimitOffset renders the LIMIT/OFFSET (or FETCH FIRST ... ROWS
+	// ONLY) tail of a query, including its leading space. It returns "" if
+	// neither limit nor offset was set.
+	RenderLimitOffset(limit uint64, limitValid bool, offset uint64, offsetValid bool) string
+
+	// ReturningKeyword is the clause keyword used to return data from DML
+	// statements: RETURNING for PostgreSQL/SQLite/MySQL, OUTPUT for SQL
+	// Server.
+	ReturningKeyword() string
+
+	// SupportsDeleteLimitOffset reports whether this dialect allows a
+	// row-limiting clause (LIMIT/OFFSET or OFFSET ... FETCH) on a DELETE
+	// statement. Oracle and SQL Server only accept row-limiting clauses on
+	// SELECT, so DeleteBuilder.ToSql returns an error for those dialects
+	// instead of emitting SQL the server would reject.
+	SupportsDeleteLimitOffset() bool
+
+	// MaxPlaceholders is the largest number of bound parameters the
+	// dialect's driver accepts in a single statement, used to size chunked
+	// IN(...) batches. 0 means unbounded.
+	MaxPlaceholders() int
+
+	// StringLiteral quotes and escapes a string for inline use in SQL text
+	// (used by ToBoundSql-style debugging output). The result includes the
+	// surrounding quotes.
+	StringLiteral(value string) string
+
+	// TimeLiteral renders a time.Time as an inline SQL literal the way the
+	// dialect expects it (used by ToBoundSql-style debugging output).
+	TimeLiteral(value time.Time) string
+
+	// BytesLiteral renders a []byte as an inline SQL literal the way the
+	// dialect expects it (used by ToBoundSql-style debugging output):
+	// X'<hex>' for MySQL/SQLite/SQL Server, '\x<hex>' for Postgres.
+	BytesLiteral(value []byte) string
+}
+
+type dialect struct {
+	placeholderFormat            PlaceholderFormat
+	quote                        string
+	boolTrue                     string
+	boolFalse                    string
+	fetchStyle                   bool
+	returningKeyword             string
+	maxPlaceholders              int
+	backslashEscapes             bool
+	deleteLimitOffsetUnsupported bool
+	timeLiteral                  func(time.Time) string
+	bytesLiteral                 func([]byte) string
+}
+
+func (d dialect) PlaceholderFormat() PlaceholderFormat {
+	return d.placeholderFormat
+}
+
+func (d dialect) QuoteIdentifier(name string) string {
+	if d.quote == "" {
+		return name
+	}
+	open, close := d.quote[0:1], d.quote[len(d.quote)-1:]
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		parts[i] = open + part + close
+	}
+	return strings.Join(parts, ".")
+}
+
+func (d dialect) BoolLiteral(value bool) string {
+	if value {
+		return d.boolTrue
+	}
+	return d.boolFalse
+}
+
+func (d dialect) RenderLimitOffset(limit uint64, limitValid bool, offset uint64, offsetValid bool) string {
+	if !limitValid && !offsetValid {
+		return ""
+	}
+
+	if !d.fetchStyle {
+		buf := &strings.Builder{}
+		if limitValid {
+			buf.WriteString(" LIMIT ")
+			buf.WriteString(strconv.FormatUint(limit, 10))
+		}
+		if offsetValid {
+			buf.WriteString(" OFFSET ")
+			buf.WriteString(strconv.FormatUint(offset, 10))
+		}
+		return buf.String()
+	}
+
+	buf := &strings.Builder{}
+	if offsetValid {
+		buf.WriteString(" OFFSET ")
+		buf.WriteString(strconv.FormatUint(offset, 10))
+		buf.WriteString(" ROWS")
+	}
+	if limitValid {
+		buf.WriteString(" FETCH FIRST ")
+		buf.WriteString(strconv.FormatUint(limit, 10))
+		buf.WriteString(" ROWS ONLY")
+	}
+	return buf.String()
+}
+
+func (d dialect) ReturningKeyword() string {
+	return d.returningKeyword
+}
+
+func (d dialect) SupportsDeleteLimitOffset() bool {
+	return !d.deleteLimitOffsetUnsupported
+}
+
+func (d dialect) MaxPlaceholders() int {
+	return d.maxPlaceholders
+}
+
+func (d dialect) StringLiteral(value string) string {
+	if d.backslashEscapes {
+		value = strings.ReplaceAll(value, `\`, `\\`)
+	}
+	value = strings.ReplaceAll(value, `'`, `''`)
+	return "'" + value + "'"
+}
+
+func (d dialect) TimeLiteral(value time.Time) string {
+	if d.timeLiteral != nil {
+		return d.timeLiteral(value)
+	}
+	return "'" + value.Format("2006-01-02 15:04:05") + "'"
+}
+
+func (d dialect) BytesLiteral(value []byte) string {
+	if d.bytesLiteral != nil {
+		return d.bytesLiteral(value)
+	}
+	return "X'" + hex.EncodeToString(value) + "'"
+}
+
+var (
+	// MySQL renders `?` placeholders, backtick-quoted identifiers and
+	// LIMIT/OFFSET; it has no RETURNING/OUTPUT support prior to MySQL
+	// 8.0.21, so ReturningKeyword is kept as RETURNING for engines that do
+	// support it rather than erroring outright.
+	MySQL Dialect = dialect{
+		placeholderFormat: Question,
+		quote:             "``",
+		boolTrue:          "1",
+		boolFalse:         "0",
+		returningKeyword:  "RETURNING",
+		maxPlaceholders:   65535,
+		backslashEscapes:  true,
+	}
+
+	// Postgres renders `$1`-style placeholders, double-quoted identifiers,
+	// LIMIT/OFFSET and RETURNING. []byte values are rendered in its bytea
+	// hex format ('\x<hex>') rather than the X'<hex>' syntax the other
+	// dialects use, since Postgres doesn't accept that syntax.
+	Postgres Dialect = dialect{
+		placeholderFormat: Dollar,
+		quote:             `""`,
+		boolTrue:          "TRUE",
+		boolFalse:         "FALSE",
+		returningKeyword:  "RETURNING",
+		maxPlaceholders:   65535,
+		timeLiteral: func(t time.Time) string {
+			return "'" + t.Format("2006-01-02 15:04:05.999999-07:00") + "'"
+		},
+		bytesLiteral: func(b []byte) string {
+			return `'\x` + hex.EncodeToString(b) + `'`
+		},
+	}
+
+	// SQLite renders `?` placeholders, double-quoted identifiers,
+	// LIMIT/OFFSET and RETURNING (3.35+).
+	SQLite Dialect = dialect{
+		placeholderFormat: Question,
+		quote:             `""`,
+		boolTrue:          "1",
+		boolFalse:         "0",
+		returningKeyword:  "RETURNING",
+		maxPlaceholders:   999,
+	}
+
+	// Oracle renders `:1`-style placeholders, double-quoted identifiers and,
+	// from RenderLimitOffset, the standard OFFSET ... ROWS FETCH FIRST ...
+	// ROWS ONLY pagination. SelectBuilder does not call RenderLimitOffset, so
+	// a SELECT built with this dialect bound still renders LIMIT/OFFSET.
+	// Oracle does not accept that clause on DELETE, so
+	// DeleteBuilder.ToSql returns an error if Limit/Offset is set with this
+	// dialect bound.
+	Oracle Dialect = dialect{
+		placeholderFormat:            Colon,
+		quote:                        `""`,
+		boolTrue:                     "1",
+		boolFalse:                    "0",
+		fetchStyle:                   true,
+		returningKeyword:             "RETURNING",
+		maxPlaceholders:              65535,
+		deleteLimitOffsetUnsupported: true,
+		timeLiteral: func(t time.Time) string {
+			return "TO_DATE('" + t.Format("2006-01-02 15:04:05") + "', 'YYYY-MM-DD HH24:MI:SS')"
+		},
+	}
+
+	// SQLServer renders `@p1`-style placeholders and bracket-quoted
+	// identifiers. Its ReturningKeyword is OUTPUT, but DeleteBuilder does
+	// not yet render OUTPUT's required clause position (before WHERE) or
+	// inserted./deleted. column qualification, so combining Returning with
+	// this dialect currently returns an error rather than emitting invalid
+	// T-SQL. SQL Server also only accepts its OFFSET ... ROWS FETCH FIRST
+	// ... ROWS ONLY pagination on SELECT, not DELETE, so Limit/Offset with
+	// this dialect bound returns an error too.
+	SQLServer Dialect = dialect{
+		placeholderFormat:            At,
+		quote:                        "[]",
+		boolTrue:                     "1",
+		boolFalse:                    "0",
+		fetchStyle:                   true,
+		returningKeyword:             "OUTPUT",
+		maxPlaceholders:              2100,
+		deleteLimitOffsetUnsupported: true,
+		timeLiteral: func(t time.Time) string {
+			return "'" + t.Format("2006-01-02 15:04:05.000") + "'"
+		},
+	}
+
+	// defaultDialect is used by ToBoundSql when no Dialect was bound via
+	// StatementBuilderType.Dialect, using ANSI-ish defaults (double-quoted
+	// identifiers, TRUE/FALSE booleans).
+	defaultDialect Dialect = dialect{
+		placeholderFormat: Question,
+		quote:             `""`,
+		boolTrue:          "TRUE",
+		boolFalse:         "FALSE",
+		returningKeyword:  "RETURNING",
+	}
+)
+
+// Rebind converts a `?`-placeholder SQL string (as produced by ToSql with
+// the Question PlaceholderFormat) to the placeholder style of the given
+// dialect. It mirrors sqlx.Rebind and is meant for converting an
+// already-built query at runtime, e.g. when the same query is dispatched
+// against multiple databases.
+func Rebind(d Dialect, sql string) string {
+	out, err := d.PlaceholderFormat().ReplacePlaceholders(sql)
+	if err != nil {
+		return sql
+	}
+	return out
+}
@@ -0,0 +1,80 @@
+package sqrl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDialectQuoteIdentifier(t *testing.T) {
+	assert.Equal(t, "`users`", MySQL.QuoteIdentifier("users"))
+	assert.Equal(t, `"users"`, Postgres.QuoteIdentifier("users"))
+	assert.Equal(t, `"users"."id"`, Postgres.QuoteIdentifier("users.id"))
+	assert.Equal(t, "[users]", SQLServer.QuoteIdentifier("users"))
+}
+
+func TestDialectBoolLiteral(t *testing.T) {
+	assert.Equal(t, "TRUE", Postgres.BoolLiteral(true))
+	assert.Equal(t, "FALSE", Postgres.BoolLiteral(false))
+	assert.Equal(t, "1", MySQL.BoolLiteral(true))
+	assert.Equal(t, "0", MySQL.BoolLiteral(false))
+}
+
+func TestRebind(t *testing.T) {
+	sql := "DELETE FROM a WHERE x = ? AND y = ?"
+
+	assert.Equal(t, "DELETE FROM a WHERE x = $1 AND y = $2", Rebind(Postgres, sql))
+	assert.Equal(t, "DELETE FROM a WHERE x = :1 AND y = :2", Rebind(Oracle, sql))
+	assert.Equal(t, "DELETE FROM a WHERE x = @p1 AND y = @p2", Rebind(SQLServer, sql))
+	assert.Equal(t, sql, Rebind(MySQL, sql))
+}
+
+func TestDeleteBuilderDialectPostgres(t *testing.T) {
+	b := StatementBuilder.Dialect(Postgres).Delete("a").
+		Where("x = ? AND y = ?", 1, 2).
+		Limit(2).
+		Offset(3).
+		ReturningAll()
+
+	sql, args, err := b.ToSql()
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETE FROM a WHERE x = $1 AND y = $2 LIMIT 2 OFFSET 3 RETURNING *", sql)
+	assert.Equal(t, []interface{}{1, 2}, args)
+}
+
+func TestDeleteBuilderDialectSQLServer(t *testing.T) {
+	b := StatementBuilder.Dialect(SQLServer).Delete("a").
+		Where("x = ?", 1)
+
+	sql, args, err := b.ToSql()
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETE FROM a WHERE x = @p1", sql)
+	assert.Equal(t, []interface{}{1}, args)
+}
+
+func TestDeleteBuilderDialectSQLServerReturningErr(t *testing.T) {
+	b := StatementBuilder.Dialect(SQLServer).Delete("a").
+		Where("x = ?", 1).
+		Returning("id")
+
+	_, _, err := b.ToSql()
+	assert.Error(t, err)
+}
+
+func TestDeleteBuilderDialectSQLServerLimitErr(t *testing.T) {
+	b := StatementBuilder.Dialect(SQLServer).Delete("a").
+		Where("x = ?", 1).
+		Limit(5)
+
+	_, _, err := b.ToSql()
+	assert.Error(t, err)
+}
+
+func TestDeleteBuilderDialectOracleLimitErr(t *testing.T) {
+	b := StatementBuilder.Dialect(Oracle).Delete("a").
+		Where("x = ?", 1).
+		Offset(5)
+
+	_, _, err := b.ToSql()
+	assert.Error(t, err)
+}
@@ -0,0 +1,108 @@
+package sqrl
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// tableInfo is the table name and primary key column inferred from a
+// struct's db tags, cached per type to avoid repeated reflection.
+type tableInfo struct {
+	table string
+	pk    string
+}
+
+var tableInfoCache sync.Map // map[reflect.Type]tableInfo
+
+// tableInfoFor returns the tableInfo for T, inferred from a db struct tag of
+// the form `db:"<table>,pk=<column>"` on one of T's fields (conventionally
+// the field mapping to the primary key column itself). The result is cached
+// per type.
+func tableInfoFor[T any]() (tableInfo, error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return tableInfo{}, fmt.Errorf("sqrl: %s is not a struct", t)
+	}
+
+	if cached, ok := tableInfoCache.Load(t); ok {
+		return cached.(tableInfo), nil
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("db")
+		if tag == "" {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		table := parts[0]
+		for _, opt := range parts[1:] {
+			col, ok := strings.CutPrefix(opt, "pk=")
+			if !ok {
+				continue
+			}
+			info := tableInfo{table: table, pk: col}
+			tableInfoCache.Store(t, info)
+			return info, nil
+		}
+	}
+
+	return tableInfo{}, fmt.Errorf(`sqrl: %s has no db struct tag declaring table and primary key, e.g. `+"`db:\"users,pk=id\"`", t)
+}
+
+// DeleteWhere deletes rows from T's table (as declared by its db struct
+// tag) matching pred, and returns the number of rows affected. It composes
+// DeleteBuilder under the hood: b supplies the PlaceholderFormat/Dialect
+// (pass sqrl.StatementBuilder for the package defaults, or e.g.
+// StatementBuilder.Dialect(Postgres) to bind one), RunWith is used as the
+// runner and ctx is threaded through as usual. Drop to b.Delete(table)
+// directly when Returning, Using or other DeleteBuilder features are
+// needed. Named DeleteWhere rather than Delete to avoid colliding with the
+// existing non-generic DeleteBuilder constructor of the same name.
+func DeleteWhere[T any](ctx context.Context, b StatementBuilderType, db BaseRunner, pred interface{}, args ...interface{}) (int64, error) {
+	info, err := tableInfoFor[T]()
+	if err != nil {
+		return 0, err
+	}
+	return b.Delete(info.table).
+		Where(pred, args...).
+		RunWith(db).
+		RowsAffected().
+		ExecContext(ctx)
+}
+
+// DeleteByID deletes the row from T's table whose primary key column equals
+// id, and returns the number of rows affected. See DeleteWhere for the role
+// of b.
+func DeleteByID[T any](ctx context.Context, b StatementBuilderType, db BaseRunner, id interface{}) (int64, error) {
+	info, err := tableInfoFor[T]()
+	if err != nil {
+		return 0, err
+	}
+	return b.Delete(info.table).
+		Where(Eq{info.pk: id}).
+		RunWith(db).
+		RowsAffected().
+		ExecContext(ctx)
+}
+
+// DeleteByIDs deletes the rows from T's table whose primary key column is in
+// ids, and returns the number of rows affected. See DeleteWhere for the role
+// of b.
+func DeleteByIDs[T any](ctx context.Context, b StatementBuilderType, db BaseRunner, ids ...interface{}) (int64, error) {
+	info, err := tableInfoFor[T]()
+	if err != nil {
+		return 0, err
+	}
+	return b.Delete(info.table).
+		Where(Eq{info.pk: ids}).
+		RunWith(db).
+		RowsAffected().
+		ExecContext(ctx)
+}
@@ -0,0 +1,60 @@
+package sqrl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type genericUser struct {
+	ID   int64  `db:"users,pk=id"`
+	Name string `db:"name"`
+}
+
+type untaggedUser struct {
+	ID   int64
+	Name string
+}
+
+func TestDeleteWhere(t *testing.T) {
+	db := &DBStub{res: &resultStub{}}
+
+	_, err := DeleteWhere[genericUser](context.Background(), StatementBuilder, db, "name = ?", "bob")
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETE FROM users WHERE name = ?", db.LastExecSql)
+}
+
+func TestDeleteByID(t *testing.T) {
+	db := &DBStub{res: &resultStub{}}
+
+	_, err := DeleteByID[genericUser](context.Background(), StatementBuilder, db, 42)
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETE FROM users WHERE id = ?", db.LastExecSql)
+	assert.Equal(t, []interface{}{42}, db.LastExecArgs)
+}
+
+func TestDeleteByIDs(t *testing.T) {
+	db := &DBStub{res: &resultStub{}}
+
+	_, err := DeleteByIDs[genericUser](context.Background(), StatementBuilder, db, 1, 2, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETE FROM users WHERE id IN (?,?,?)", db.LastExecSql)
+	assert.Equal(t, []interface{}{1, 2, 3}, db.LastExecArgs)
+}
+
+func TestDeleteByIDDialect(t *testing.T) {
+	db := &DBStub{res: &resultStub{}}
+
+	_, err := DeleteByID[genericUser](context.Background(), StatementBuilder.Dialect(Postgres), db, 42)
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETE FROM users WHERE id = $1", db.LastExecSql)
+	assert.Equal(t, []interface{}{42}, db.LastExecArgs)
+}
+
+func TestDeleteByIDMissingTag(t *testing.T) {
+	db := &DBStub{res: &resultStub{}}
+
+	_, err := DeleteByID[untaggedUser](context.Background(), StatementBuilder, db, 42)
+	assert.Error(t, err)
+}
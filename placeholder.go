@@ -0,0 +1,107 @@
+package sqrl
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// PlaceholderFormat is the interface that wraps the ReplacePlaceholders method.
+//
+// ReplacePlaceholders takes a SQL statement and replaces each question mark
+// placeholder with a (possibly different) SQL placeholder.
+type PlaceholderFormat interface {
+	ReplacePlaceholders(sql string) (string, error)
+}
+
+var (
+	// Question is a PlaceholderFormat instance that leaves placeholders as
+	// question marks.
+	Question = questionFormat{}
+
+	// Dollar is a PlaceholderFormat instance that replaces placeholders with
+	// dollar-prefixed positional placeholders (e.g. $1, $2, $3).
+	Dollar = dollarFormat{}
+
+	// Colon is a PlaceholderFormat instance that replaces placeholders with
+	// colon-prefixed positional placeholders (e.g. :1, :2, :3), as used by
+	// Oracle.
+	Colon = colonFormat{}
+
+	// At is a PlaceholderFormat instance that replaces placeholders with
+	// at-sign-prefixed positional placeholders (e.g. @p1, @p2, @p3), as
+	// used by SQL Server.
+	At = atFormat{}
+)
+
+type questionFormat struct{}
+
+func (_ questionFormat) ReplacePlaceholders(sql string) (string, error) {
+	return sql, nil
+}
+
+type dollarFormat struct{}
+
+func (_ dollarFormat) ReplacePlaceholders(sql string) (string, error) {
+	return replacePlaceholders(sql, func(buf *bytes.Buffer, i int) error {
+		fmt.Fprintf(buf, "$%d", i)
+		return nil
+	})
+}
+
+type colonFormat struct{}
+
+func (_ colonFormat) ReplacePlaceholders(sql string) (string, error) {
+	return replacePlaceholders(sql, func(buf *bytes.Buffer, i int) error {
+		fmt.Fprintf(buf, ":%d", i)
+		return nil
+	})
+}
+
+type atFormat struct{}
+
+func (_ atFormat) ReplacePlaceholders(sql string) (string, error) {
+	return replacePlaceholders(sql, func(buf *bytes.Buffer, i int) error {
+		fmt.Fprintf(buf, "@p%d", i)
+		return nil
+	})
+}
+
+// Placeholders returns a string with count ? placeholders joined with commas.
+func Placeholders(count int) string {
+	if count < 1 {
+		return ""
+	}
+
+	return strings.Repeat(",?", count)[1:]
+}
+
+func replacePlaceholders(sql string, replace func(buf *bytes.Buffer, i int) error) (string, error) {
+	buf := &bytes.Buffer{}
+	i := 0
+	for {
+		p := strings.Index(sql, "?")
+		if p == -1 {
+			break
+		}
+
+		if len(sql[p:]) > 1 && sql[p:p+2] == "??" { // escape ?? => ?
+			buf.WriteString(sql[:p])
+			buf.WriteString("?")
+			if len(sql[p:]) == 1 {
+				break
+			}
+			sql = sql[p+2:]
+		} else {
+			i++
+			buf.WriteString(sql[:p])
+			if err := replace(buf, i); err != nil {
+				return "", err
+			}
+			sql = sql[p+1:]
+		}
+	}
+
+	buf.WriteString(sql)
+	return buf.String(), nil
+}